@@ -0,0 +1,106 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CacheStorageGroup is the API group of the CacheStorage resource
+	CacheStorageGroup = "storage.cloud.atomix.io"
+	// CacheStorageVersion is the API version of the CacheStorage resource
+	CacheStorageVersion = "v1beta1"
+	// CacheStorageKind is the kind of the CacheStorage resource
+	CacheStorageKind = "CacheStorage"
+)
+
+// CacheStorageSpec defines the desired state of CacheStorage
+type CacheStorageSpec struct {
+	// Image is the local-replica image to run
+	Image string `json:"image,omitempty"`
+
+	// ImagePullPolicy is the pull policy to apply to the local-replica image
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// Resources is the compute resources required by the local-replica container
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// StorageClassName is the name of the StorageClass to use for the data volume.
+	// If not set, an emptyDir volume is used instead of a PersistentVolumeClaim.
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// StorageSize is the size of the PersistentVolumeClaim requested for the data volume
+	StorageSize string `json:"storageSize,omitempty"`
+
+	// PersistentVolumeReclaimPolicy determines whether the PersistentVolumeClaim created for the
+	// data volume is deleted when the owning Cluster is deleted. Defaults to Delete.
+	PersistentVolumeReclaimPolicy corev1.PersistentVolumeReclaimPolicy `json:"persistentVolumeReclaimPolicy,omitempty"`
+
+	// PublishClusterProfile opts a Cluster into publishing a ClusterProfile (cluster-inventory-api)
+	// once its partitions are ready, so external multi-cluster consumers can discover it
+	PublishClusterProfile *bool `json:"publishClusterProfile,omitempty"`
+}
+
+// CacheStorageConditionType is a type of condition a CacheStorage can report in its status
+type CacheStorageConditionType string
+
+const (
+	// CacheStorageConditionReady indicates whether the CacheStorage's Deployment has all replicas ready
+	CacheStorageConditionReady CacheStorageConditionType = "Ready"
+)
+
+// CacheStorageCondition describes an observation of a CacheStorage's state at a point in time
+type CacheStorageCondition struct {
+	// Type is the type of the condition
+	Type CacheStorageConditionType `json:"type"`
+
+	// Status is the status of the condition, one of True, False, or Unknown
+	Status corev1.ConditionStatus `json:"status"`
+
+	// Reason is a brief CamelCase reason for the condition's last transition
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable message indicating details about the last transition
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time the condition transitioned from one status to another
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// CacheStorageStatus defines the observed state of CacheStorage
+type CacheStorageStatus struct {
+	// Conditions represent the latest available observations of the CacheStorage's state
+	Conditions []CacheStorageCondition `json:"conditions,omitempty"`
+}
+
+// CacheStorage is the Schema for the cachestorages API
+// +k8s:deepcopy-gen:interfaces=runtime.Object
+type CacheStorage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheStorageSpec   `json:"spec,omitempty"`
+	Status CacheStorageStatus `json:"status,omitempty"`
+}
+
+// CacheStorageList contains a list of CacheStorage
+// +k8s:deepcopy-gen:interfaces=runtime.Object
+type CacheStorageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheStorage `json:"items"`
+}
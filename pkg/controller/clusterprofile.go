@@ -0,0 +1,101 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/atomix/kubernetes-controller/pkg/apis/cloud/v1beta2"
+	"github.com/atomix/local-replica/pkg/apis/storage/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clusterinventoryv1alpha1 "sigs.k8s.io/cluster-inventory-api/apis/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// clusterProfileProperty names advertising this cache instance through the cluster-inventory-api
+const (
+	clusterProfileProtocolProperty   = "atomix.io/protocol"
+	clusterProfileProtocolValue      = "cache"
+	clusterProfilePartitionsProperty = "atomix.io/partitions"
+	clusterProfileMembersProperty    = "atomix.io/members"
+)
+
+// reconcileClusterProfile publishes a ClusterProfile describing the atomix cache endpoint once the
+// cluster's partitions are all ready, so external multi-cluster consumers can discover it through the
+// standard cluster-inventory-api rather than atomix-specific CRDs.
+func (r *Reconciler) reconcileClusterProfile(c client.Client, cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
+	if storage.Spec.PublishClusterProfile == nil || !*storage.Spec.PublishClusterProfile {
+		return r.reconcileClusterProfileDelete(c, cluster)
+	}
+	if cluster.Status.ReadyPartitions < cluster.Spec.Partitions {
+		return nil
+	}
+
+	profile := &clusterinventoryv1alpha1.ClusterProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      cluster.Name,
+		},
+		Spec: clusterinventoryv1alpha1.ClusterProfileSpec{
+			DisplayName: cluster.Annotations["cloud.atomix.io/cluster"],
+		},
+	}
+
+	log.Info("Applying ClusterProfile", "Name", profile.Name, "Namespace", profile.Namespace)
+	if err := r.applyOwnedObject(context.TODO(), c, cluster, profile); err != nil {
+		return err
+	}
+
+	// ClusterProfile has a status subresource, so the properties we're actually publishing this
+	// resource for have to be applied separately or the API server discards them.
+	profile.Status = clusterinventoryv1alpha1.ClusterProfileStatus{
+		CredentialProviders: []clusterinventoryv1alpha1.CredentialProvider{},
+		Properties: []clusterinventoryv1alpha1.Property{
+			{Name: clusterProfileProtocolProperty, Value: clusterProfileProtocolValue},
+			{Name: clusterProfilePartitionsProperty, Value: strconv.FormatInt(int64(cluster.Spec.Partitions), 10)},
+			{Name: clusterProfileMembersProperty, Value: fmt.Sprintf("%s.%s.svc.cluster.local", cluster.Namespace, cluster.Name)},
+		},
+	}
+	log.Info("Applying ClusterProfile status", "Name", profile.Name, "Namespace", profile.Namespace)
+	return r.applyOwnedObjectStatus(context.TODO(), c, cluster, profile)
+}
+
+// reconcileClusterProfileDelete removes a previously published ClusterProfile once PublishClusterProfile
+// is turned back off, rather than leaving it in place until the owning Cluster itself is deleted.
+func (r *Reconciler) reconcileClusterProfileDelete(c client.Client, cluster *v1beta2.Cluster) error {
+	profile := &clusterinventoryv1alpha1.ClusterProfile{}
+	name := types.NamespacedName{
+		Namespace: cluster.Namespace,
+		Name:      cluster.Name,
+	}
+	err := c.Get(context.TODO(), name, profile)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	log.Info("Deleting ClusterProfile", "Name", profile.Name, "Namespace", profile.Namespace)
+	err = c.Delete(context.TODO(), profile)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// configMapPredicate filters ConfigMap events down to changes in the cluster config data we generate
+type configMapPredicate struct {
+}
+
+func (p *configMapPredicate) Create(e event.CreateEvent) bool {
+	return true
+}
+
+func (p *configMapPredicate) Delete(e event.DeleteEvent) bool {
+	return true
+}
+
+func (p *configMapPredicate) Update(e event.UpdateEvent) bool {
+	oldCm, ok := e.ObjectOld.(*corev1.ConfigMap)
+	if !ok {
+		return false
+	}
+	newCm, ok := e.ObjectNew.(*corev1.ConfigMap)
+	if !ok {
+		return false
+	}
+	return oldCm.Data[clusterConfigFile] != newCm.Data[clusterConfigFile]
+}
+
+func (p *configMapPredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// deploymentPredicate filters Deployment events down to the ones that can move reconciliation forward:
+// a change in replica counts or a new rollout of the pod template
+type deploymentPredicate struct {
+}
+
+func (p *deploymentPredicate) Create(e event.CreateEvent) bool {
+	return true
+}
+
+func (p *deploymentPredicate) Delete(e event.DeleteEvent) bool {
+	return true
+}
+
+func (p *deploymentPredicate) Update(e event.UpdateEvent) bool {
+	oldDep, ok := e.ObjectOld.(*appsv1.Deployment)
+	if !ok {
+		return false
+	}
+	newDep, ok := e.ObjectNew.(*appsv1.Deployment)
+	if !ok {
+		return false
+	}
+
+	if oldDep.Status.ReadyReplicas != newDep.Status.ReadyReplicas {
+		return true
+	}
+	if oldDep.Status.Replicas != newDep.Status.Replicas {
+		return true
+	}
+	if oldDep.Status.UpdatedReplicas != newDep.Status.UpdatedReplicas {
+		return true
+	}
+	// pod-template-hash is stamped onto the ReplicaSet/Pods the Deployment controller creates, not onto
+	// the Deployment itself, so a rollout is detected through its revision annotation instead.
+	return oldDep.Annotations["deployment.kubernetes.io/revision"] != newDep.Annotations["deployment.kubernetes.io/revision"]
+}
+
+func (p *deploymentPredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
@@ -0,0 +1,214 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+)
+
+// contextFromStopChannel returns a context that's cancelled when stop is closed, for adapting the
+// controller-runtime Runnable interface's stop channel to the context-based ClusterProvider.Run
+func contextFromStopChannel(stop <-chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// EngageFunc is called by a ClusterProvider when a new cluster becomes available for reconciliation
+type EngageFunc func(ctx context.Context, name string, cl cluster.Cluster) error
+
+// DisengageFunc is called by a ClusterProvider when a cluster is no longer available for reconciliation
+type DisengageFunc func(name string)
+
+// ClusterProvider yields the named clusters a controller-manager should reconcile CacheStorage/Cluster
+// objects against. Clusters may be engaged and disengaged over the lifetime of the provider; Run blocks,
+// invoking the given callbacks as clusters come and go.
+type ClusterProvider interface {
+	// Get returns the engaged cluster with the given name, if any
+	Get(name string) (cluster.Cluster, bool)
+
+	// Run starts the provider, invoking onEngage for every cluster it discovers and onDisengage when a
+	// previously engaged cluster goes away. Run blocks until the context is cancelled.
+	Run(ctx context.Context, onEngage EngageFunc, onDisengage DisengageFunc) error
+}
+
+// SingleClusterProvider is a ClusterProvider that engages a single, already-constructed cluster. It
+// preserves the pre-fleet behavior of reconciling only the cluster the controller-manager is running in.
+type SingleClusterProvider struct {
+	// Name is the name under which the cluster is engaged
+	Name string
+
+	// Cluster is the cluster to engage
+	Cluster cluster.Cluster
+}
+
+// Get implements ClusterProvider
+func (p *SingleClusterProvider) Get(name string) (cluster.Cluster, bool) {
+	if name != p.Name {
+		return nil, false
+	}
+	return p.Cluster, true
+}
+
+// Run implements ClusterProvider
+func (p *SingleClusterProvider) Run(ctx context.Context, onEngage EngageFunc, onDisengage DisengageFunc) error {
+	if err := onEngage(ctx, p.Name, p.Cluster); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return nil
+}
+
+// KubeconfigDirProvider is a ClusterProvider that watches a directory of kubeconfigs, engaging a
+// cluster for each file it finds and disengaging it when the file is removed. The cluster name is the
+// kubeconfig file's name with its extension stripped.
+type KubeconfigDirProvider struct {
+	// Dir is the directory to watch for kubeconfigs
+	Dir string
+
+	// Scheme is the scheme used to construct engaged clusters
+	Scheme *runtime.Scheme
+
+	// PollInterval is how often the directory is rescanned for changes. Defaults to 10 seconds.
+	PollInterval time.Duration
+
+	mu       sync.Mutex
+	engaged  map[string]context.CancelFunc
+	clusters map[string]cluster.Cluster
+}
+
+// Get implements ClusterProvider
+func (p *KubeconfigDirProvider) Get(name string) (cluster.Cluster, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	cl, ok := p.clusters[name]
+	return cl, ok
+}
+
+// Run implements ClusterProvider
+func (p *KubeconfigDirProvider) Run(ctx context.Context, onEngage EngageFunc, onDisengage DisengageFunc) error {
+	interval := p.PollInterval
+	if interval == 0 {
+		interval = 10 * time.Second
+	}
+
+	p.mu.Lock()
+	p.engaged = make(map[string]context.CancelFunc)
+	p.clusters = make(map[string]cluster.Cluster)
+	p.mu.Unlock()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.reconcileDir(ctx, onEngage, onDisengage); err != nil {
+			log.Error(err, "Failed to reconcile kubeconfig directory", "Dir", p.Dir)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *KubeconfigDirProvider) reconcileDir(ctx context.Context, onEngage EngageFunc, onDisengage DisengageFunc) error {
+	files, err := ioutil.ReadDir(p.Dir)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		name := fileNameWithoutExt(file.Name())
+		seen[name] = true
+
+		p.mu.Lock()
+		_, ok := p.engaged[name]
+		p.mu.Unlock()
+		if ok {
+			continue
+		}
+
+		if err := p.engageFile(ctx, name, file.Name(), onEngage); err != nil {
+			log.Error(err, "Failed to engage cluster", "Name", name)
+		}
+	}
+
+	p.mu.Lock()
+	for name, cancel := range p.engaged {
+		if !seen[name] {
+			cancel()
+			delete(p.engaged, name)
+			delete(p.clusters, name)
+			onDisengage(name)
+		}
+	}
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *KubeconfigDirProvider) engageFile(ctx context.Context, name string, fileName string, onEngage EngageFunc) error {
+	config, err := clientcmd.BuildConfigFromFlags("", filepath.Join(p.Dir, fileName))
+	if err != nil {
+		return err
+	}
+
+	cl, err := cluster.New(config, func(o *cluster.Options) { o.Scheme = p.Scheme })
+	if err != nil {
+		return err
+	}
+
+	clusterCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		if err := cl.Start(clusterCtx.Done()); err != nil {
+			log.Error(err, "Cluster cache stopped", "Name", name)
+		}
+	}()
+
+	if err := onEngage(ctx, name, cl); err != nil {
+		cancel()
+		return err
+	}
+
+	p.mu.Lock()
+	p.engaged[name] = cancel
+	p.clusters[name] = cl
+	p.mu.Unlock()
+	return nil
+}
+
+func fileNameWithoutExt(name string) string {
+	ext := filepath.Ext(name)
+	return name[:len(name)-len(ext)]
+}
@@ -20,23 +20,29 @@ import (
 	"fmt"
 	api "github.com/atomix/api/proto/atomix/controller"
 	"github.com/atomix/kubernetes-controller/pkg/apis/cloud/v1beta2"
-	"github.com/atomix/kubernetes-controller/pkg/controller/v1beta2/storage"
 	"github.com/atomix/kubernetes-controller/pkg/controller/v1beta2/util/k8s"
 	"github.com/atomix/local-replica/pkg/apis/storage/v1beta1"
 	"github.com/gogo/protobuf/jsonpb"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	"strconv"
+	"sync"
 )
 
 const (
@@ -44,40 +50,151 @@ const (
 	clusterConfigFile  = "cluster.json"
 	protocolConfigFile = "protocol.json"
 	dataPath           = "/var/lib/atomix"
+	dataVolume         = "data"
+	configVolume       = "config"
 )
 
 const port = 5678
 
+const defaultStorageSize = "1Gi"
+
+// fieldManager is the field manager name used when applying objects owned by this controller via
+// Server-Side Apply. It must remain stable across releases so our field ownership is preserved.
+const fieldManager = "atomix-local-replica-controller"
+
+// finalizer is added to Clusters so we can run graceful teardown before the Cluster is removed
+const finalizer = "storage.cloud.atomix.io/local-replica"
+
+// localClusterName is the cluster name under which the controller-manager's own cluster is engaged
+// when no ClusterProvider is configured
+const localClusterName = "local"
+
 var log = logf.Log.WithName("controller_test")
 
 // Add creates a new Partition ManagementGroup and adds it to the Manager. The Manager will set fields on the ManagementGroup
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
+	return AddWithClusterProvider(mgr, &SingleClusterProvider{Name: localClusterName, Cluster: mgr})
+}
+
+// AddWithClusterProvider creates a new Reconciler backed by the given ClusterProvider and engages every
+// cluster the provider yields, so that a single controller-manager can reconcile CacheStorage/Cluster
+// objects across a fleet of Kubernetes clusters rather than just the one it's running in.
+func AddWithClusterProvider(mgr manager.Manager, provider ClusterProvider) error {
 	reconciler := &Reconciler{
-		client: mgr.GetClient(),
-		scheme: mgr.GetScheme(),
+		provider: provider,
+		scheme:   mgr.GetScheme(),
+	}
+
+	onEngage := func(ctx context.Context, name string, cl cluster.Cluster) error {
+		return engageCluster(mgr, reconciler, name, cl)
+	}
+	onDisengage := func(name string) {
+		log.Info("Cluster disengaged", "Name", name)
+	}
+	return mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+		ctx, cancel := contextFromStopChannel(stop)
+		defer cancel()
+		return provider.Run(ctx, onEngage, onDisengage)
+	}))
+}
+
+// engageCluster registers the primary and secondary watches needed to reconcile Cluster objects in cl,
+// tagging every reconcile.Request enqueued from it with the given cluster name. Every watch is bound to
+// cl's own cache rather than mgr's. Controllers are named after the engaged cluster plus a generation
+// counter, since a disengaged cluster's controllers are never torn down (controller-runtime has no
+// supported way to unregister one): without the counter, re-engaging a cluster whose kubeconfig file
+// disappeared and reappeared would call controller.New with a name it already used, which panics on the
+// resulting duplicate Prometheus collector registration.
+func engageCluster(mgr manager.Manager, reconciler *Reconciler, name string, cl cluster.Cluster) error {
+	log.Info("Cluster engaged", "Name", name)
+
+	adapter := &clusterReconciler{name: name, reconciler: reconciler}
+	controllerName := fmt.Sprintf("%s-%d", name, reconciler.nextGeneration(name))
+
+	if err := addClusterWatch(mgr, cl, controllerName, adapter); err != nil {
+		return err
 	}
-	gvk := schema.GroupVersionKind{
-		Group:   v1beta1.CacheStorageGroup,
-		Version: v1beta1.CacheStorageVersion,
-		Kind:    v1beta1.CacheStorageKind,
+	return addWatches(mgr, cl, controllerName, adapter)
+}
+
+// addClusterWatch registers the primary watch that triggers reconciliation of Cluster objects in cl. It
+// is bound to cl's cache, not mgr's, so a Cluster created or changed in a remote tenant cluster (e.g. one
+// engaged by a KubeconfigDirProvider) actually enqueues a reconcile for that cluster.
+func addClusterWatch(mgr manager.Manager, cl cluster.Cluster, controllerName string, reconciler reconcile.Reconciler) error {
+	c, err := controller.New(fmt.Sprintf("cache-storage-local-replica-%s", controllerName), mgr, controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return err
 	}
-	return storage.AddClusterReconciler(mgr, reconciler, gvk)
+	return c.Watch(source.NewKindWithCache(&v1beta2.Cluster{}, cl.GetCache()), &handler.EnqueueRequestForObject{})
 }
 
-var _ reconcile.Reconciler = &Reconciler{}
+// addWatches registers secondary watches on the ConfigMap, Deployment, and Service owned by the
+// reconciled Cluster so that out-of-band edits and status changes requeue reconciliation instead
+// of relying on polling alone.
+func addWatches(mgr manager.Manager, cl cluster.Cluster, controllerName string, reconciler reconcile.Reconciler) error {
+	c, err := controller.New(fmt.Sprintf("cache-storage-local-replica-watcher-%s", controllerName), mgr, controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return err
+	}
+
+	owner := &handler.EnqueueRequestForOwner{OwnerType: &v1beta2.Cluster{}, IsController: true}
 
-// Reconciler reconciles a Cluster object
+	informers := cl.GetCache()
+	if err := c.Watch(source.NewKindWithCache(&corev1.ConfigMap{}, informers), owner, &configMapPredicate{}); err != nil {
+		return err
+	}
+	if err := c.Watch(source.NewKindWithCache(&appsv1.Deployment{}, informers), owner, &deploymentPredicate{}); err != nil {
+		return err
+	}
+	return c.Watch(source.NewKindWithCache(&corev1.Service{}, informers), owner, &servicePredicate{})
+}
+
+// clusterReconciler adapts a Reconciler and a fixed cluster name to the reconcile.Reconciler interface,
+// so a dedicated controller can be wired up per engaged cluster.
+type clusterReconciler struct {
+	name       string
+	reconciler *Reconciler
+}
+
+var _ reconcile.Reconciler = &clusterReconciler{}
+
+func (a *clusterReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	return a.reconciler.reconcileCluster(a.name, request)
+}
+
+// Reconciler reconciles Cluster objects across every cluster its ClusterProvider engages
 type Reconciler struct {
-	client client.Client
-	scheme *runtime.Scheme
+	provider ClusterProvider
+	scheme   *runtime.Scheme
+
+	mu         sync.Mutex
+	generation map[string]int
 }
 
-// Reconcile reads that state of the cluster for a Cluster object and makes changes based on the state read
-// and what is in the Cluster.Spec
-func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
-	cluster := &v1beta2.Cluster{}
-	err := r.client.Get(context.TODO(), request.NamespacedName, cluster)
+// nextGeneration returns a monotonically increasing counter for the given cluster name, used to keep
+// controller names unique across repeated engagements of the same cluster.
+func (r *Reconciler) nextGeneration(name string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.generation == nil {
+		r.generation = make(map[string]int)
+	}
+	r.generation[name]++
+	return r.generation[name]
+}
+
+// reconcileCluster reads the state of the Cluster object named by request in the named cluster and
+// makes changes based on the state read and what is in the Cluster.Spec
+func (r *Reconciler) reconcileCluster(clusterName string, request reconcile.Request) (reconcile.Result, error) {
+	cl, ok := r.provider.Get(clusterName)
+	if !ok {
+		return reconcile.Result{}, fmt.Errorf("cluster %q is not engaged", clusterName)
+	}
+	c := cl.GetClient()
+
+	clusterObj := &v1beta2.Cluster{}
+	err := c.Get(context.TODO(), request.NamespacedName, clusterObj)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			return reconcile.Result{}, nil
@@ -85,12 +202,23 @@ func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{Requeue: true}, err
 	}
 
+	if clusterObj.GetDeletionTimestamp() != nil {
+		return r.reconcileDelete(c, clusterObj)
+	}
+
+	if !containsString(clusterObj.GetFinalizers(), finalizer) {
+		clusterObj.SetFinalizers(append(clusterObj.GetFinalizers(), finalizer))
+		if err := c.Update(context.TODO(), clusterObj); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	storage := &v1beta1.CacheStorage{}
 	name := types.NamespacedName{
-		Namespace: cluster.Spec.Storage.Namespace,
-		Name:      cluster.Spec.Storage.Name,
+		Namespace: clusterObj.Spec.Storage.Namespace,
+		Name:      clusterObj.Spec.Storage.Name,
 	}
-	err = r.client.Get(context.TODO(), name, storage)
+	err = c.Get(context.TODO(), name, storage)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			return reconcile.Result{}, nil
@@ -98,49 +226,232 @@ func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{Requeue: true}, err
 	}
 
-	err = r.reconcileConfigMap(cluster, storage)
+	err = r.reconcileConfigMap(c, clusterObj, storage)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
-	err = r.reconcileDeployment(cluster, storage)
+	err = r.reconcilePersistentVolumeClaim(c, clusterObj, storage)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
-	err = r.reconcileService(cluster, storage)
+	err = r.reconcileDeployment(c, clusterObj, storage)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
-	err = r.reconcileStatus(cluster, storage)
+	err = r.reconcileService(c, clusterObj, storage)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = r.reconcileStatus(c, clusterObj, storage)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = r.reconcileClusterProfile(c, clusterObj, storage)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 	return reconcile.Result{}, nil
 }
 
-func (r *Reconciler) reconcileConfigMap(cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
-	cm := &corev1.ConfigMap{}
+// reconcileDelete runs graceful teardown for a Cluster that has a deletion timestamp set, removing
+// the finalizer once cleanup has completed.
+func (r *Reconciler) reconcileDelete(c client.Client, cluster *v1beta2.Cluster) (reconcile.Result, error) {
+	if !containsString(cluster.GetFinalizers(), finalizer) {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.terminatePartitions(c, cluster); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	dep := &appsv1.Deployment{}
 	name := types.NamespacedName{
 		Namespace: cluster.Namespace,
 		Name:      cluster.Name,
 	}
-	err := r.client.Get(context.TODO(), name, cm)
-	if err != nil && k8serrors.IsNotFound(err) {
-		err = r.addConfigMap(cluster, storage)
+	err := c.Get(context.TODO(), name, dep)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return reconcile.Result{}, err
+	} else if err == nil {
+		if dep.Spec.Replicas == nil || *dep.Spec.Replicas != 0 {
+			log.Info("Scaling down Deployment", "Name", dep.Name, "Namespace", dep.Namespace)
+			replicas := int32(0)
+			dep.Spec.Replicas = &replicas
+			if err := c.Update(context.TODO(), dep); err != nil {
+				return reconcile.Result{}, err
+			}
+			return reconcile.Result{Requeue: true}, nil
+		}
+		if dep.Status.Replicas != 0 {
+			log.Info("Waiting for Deployment pods to terminate", "Name", dep.Name, "Namespace", dep.Namespace)
+			return reconcile.Result{Requeue: true}, nil
+		}
 	}
-	return err
+
+	if err := r.reconcilePersistentVolumeClaimDelete(c, cluster); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	cluster.SetFinalizers(removeString(cluster.GetFinalizers(), finalizer))
+	if err := c.Update(context.TODO(), cluster); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+// terminatePartitions marks all Partitions owned by the cluster as not ready while it's being torn down
+func (r *Reconciler) terminatePartitions(c client.Client, cluster *v1beta2.Cluster) error {
+	clusterID, err := k8s.GetClusterIDFromClusterAnnotations(cluster)
+	if err != nil {
+		return err
+	}
+	for partitionID := (cluster.Spec.Partitions * (clusterID - 1)) + 1; partitionID <= cluster.Spec.Partitions*clusterID; partitionID++ {
+		partition := &v1beta2.Partition{}
+		err := c.Get(context.TODO(), k8s.GetPartitionNamespacedName(cluster, partitionID), partition)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if partition.Status.Ready {
+			partition.Status.Ready = false
+			log.Info("Terminating Partition", "Name", partition.Name, "Namespace", partition.Namespace, "Reason", "Terminating")
+			if err := c.Status().Update(context.TODO(), partition); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reconcilePersistentVolumeClaimDelete deletes the data PersistentVolumeClaim unless the owning
+// CacheStorage has opted into retaining it
+func (r *Reconciler) reconcilePersistentVolumeClaimDelete(c client.Client, cluster *v1beta2.Cluster) error {
+	pvc := &corev1.PersistentVolumeClaim{}
+	name := types.NamespacedName{
+		Namespace: cluster.Namespace,
+		Name:      cluster.Name,
+	}
+	err := c.Get(context.TODO(), name, pvc)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	storage := &v1beta1.CacheStorage{}
+	storageName := types.NamespacedName{
+		Namespace: cluster.Spec.Storage.Namespace,
+		Name:      cluster.Spec.Storage.Name,
+	}
+	err = c.Get(context.TODO(), storageName, storage)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && storage.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimRetain {
+		log.Info("Retaining PersistentVolumeClaim", "Name", pvc.Name, "Namespace", pvc.Namespace)
+		return nil
+	}
+
+	log.Info("Deleting PersistentVolumeClaim", "Name", pvc.Name, "Namespace", pvc.Namespace)
+	err = c.Delete(context.TODO(), pvc)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func containsString(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
 }
 
-func (r *Reconciler) addConfigMap(cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
-	log.Info("Creating ConfigMap", "Name", cluster.Name, "Namespace", cluster.Namespace)
+func removeString(slice []string, s string) []string {
+	result := make([]string, 0, len(slice))
+	for _, item := range slice {
+		if item != s {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// applyOwnedObject applies the desired state of an object owned by the given cluster using
+// Server-Side Apply, so fields we don't set are left alone and fields we do set are re-asserted
+// on every reconcile.
+func (r *Reconciler) applyOwnedObject(ctx context.Context, c client.Client, owner *v1beta2.Cluster, obj runtime.Object) error {
+	metaObj, ok := obj.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("object %T does not implement metav1.Object", obj)
+	}
+	if err := controllerutil.SetControllerReference(owner, metaObj, r.scheme); err != nil {
+		return err
+	}
+
+	gvks, _, err := r.scheme.ObjectKinds(obj)
+	if err != nil {
+		return err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvks[0])
+	u.SetManagedFields(nil)
 
+	return c.Patch(ctx, u, client.Apply, client.ForceOwnership, client.FieldOwner(fieldManager))
+}
+
+// applyOwnedObjectStatus applies the status subresource of an object owned by the given cluster using
+// Server-Side Apply. Objects with a status subresource (e.g. ClusterProfile) silently drop any status
+// sent through the main endpoint, so it must be patched through Status() separately from its spec.
+func (r *Reconciler) applyOwnedObjectStatus(ctx context.Context, c client.Client, owner *v1beta2.Cluster, obj runtime.Object) error {
+	metaObj, ok := obj.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("object %T does not implement metav1.Object", obj)
+	}
+	if err := controllerutil.SetControllerReference(owner, metaObj, r.scheme); err != nil {
+		return err
+	}
+
+	gvks, _, err := r.scheme.ObjectKinds(obj)
+	if err != nil {
+		return err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvks[0])
+	u.SetManagedFields(nil)
+
+	return c.Status().Patch(ctx, u, client.Apply, client.ForceOwnership, client.FieldOwner(fieldManager))
+}
+
+func (r *Reconciler) reconcileConfigMap(c client.Client, cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
 	config, err := newClusterConfig(cluster)
 	if err != nil {
 		return err
 	}
 
+	// config's members/partitions are built by newClusterConfig in a fixed, increasing order and jsonpb
+	// marshals proto fields in their declared order (sorting any map keys), so MarshalToString already
+	// produces a stable string across reconciles and won't cause the ConfigMap's SSA patch to churn.
 	marshaller := jsonpb.Marshaler{}
 	data, err := marshaller.MarshalToString(config)
 	if err != nil {
@@ -153,76 +464,208 @@ func (r *Reconciler) addConfigMap(cluster *v1beta2.Cluster, storage *v1beta1.Cac
 			Name:      cluster.Name,
 		},
 		Data: map[string]string{
-			clusterConfigFile: data,
+			clusterConfigFile:  data,
+			protocolConfigFile: newProtocolConfigString(),
 		},
 	}
-	if err := controllerutil.SetControllerReference(cluster, cm, r.scheme); err != nil {
-		return err
-	}
-	return r.client.Create(context.TODO(), cm)
+	log.Info("Applying ConfigMap", "Name", cluster.Name, "Namespace", cluster.Namespace)
+	return r.applyOwnedObject(context.TODO(), c, cluster, cm)
 }
 
-func (r *Reconciler) reconcileDeployment(cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
-	dep := &appsv1.Deployment{}
+func (r *Reconciler) reconcilePersistentVolumeClaim(c client.Client, cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
+	if storage.Spec.StorageClassName == nil {
+		return nil
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
 	name := types.NamespacedName{
 		Namespace: cluster.Namespace,
 		Name:      cluster.Name,
 	}
-	err := r.client.Get(context.TODO(), name, dep)
+	err := c.Get(context.TODO(), name, pvc)
 	if err != nil && k8serrors.IsNotFound(err) {
-		err = r.addDeployment(cluster, storage)
+		err = r.addPersistentVolumeClaim(c, cluster, storage)
 	}
 	return err
 }
 
-func (r *Reconciler) addDeployment(cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
-	log.Info("Creating Deployment", "Name", cluster.Name, "Namespace", cluster.Namespace)
-	dep := &appsv1.Deployment{
+func (r *Reconciler) addPersistentVolumeClaim(c client.Client, cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
+	log.Info("Creating PersistentVolumeClaim", "Name", cluster.Name, "Namespace", cluster.Namespace)
+
+	storageSize := storage.Spec.StorageSize
+	if storageSize == "" {
+		storageSize = defaultStorageSize
+	}
+	quantity, err := resource.ParseQuantity(storageSize)
+	if err != nil {
+		return err
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: cluster.Namespace,
 			Name:      cluster.Name,
 		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			StorageClassName: storage.Spec.StorageClassName,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: quantity,
+				},
+			},
+		},
 	}
-	if err := controllerutil.SetControllerReference(cluster, dep, r.scheme); err != nil {
+	if err := controllerutil.SetControllerReference(cluster, pvc, r.scheme); err != nil {
 		return err
 	}
-	return r.client.Create(context.TODO(), dep)
+	return c.Create(context.TODO(), pvc)
 }
 
-func (r *Reconciler) reconcileService(cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
-	service := &corev1.Service{}
-	name := types.NamespacedName{
-		Namespace: cluster.Namespace,
-		Name:      cluster.Name,
+func (r *Reconciler) reconcileDeployment(c client.Client, cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
+	replicas := int32(1)
+
+	dataVolumeSource := corev1.VolumeSource{
+		EmptyDir: &corev1.EmptyDirVolumeSource{},
 	}
-	err := r.client.Get(context.TODO(), name, service)
-	if err != nil && k8serrors.IsNotFound(err) {
-		err = r.addService(cluster, storage)
+	if storage.Spec.StorageClassName != nil {
+		dataVolumeSource = corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: cluster.Name,
+			},
+		}
 	}
-	return err
+
+	// A TCPSocket check only proves the port is accepting connections, not that the gRPC server behind
+	// it is actually serving, so speak the protocol with grpc_health_probe instead.
+	probe := &corev1.Probe{
+		Handler: corev1.Handler{
+			Exec: &corev1.ExecAction{
+				Command: []string{"/bin/grpc_health_probe", fmt.Sprintf("-addr=:%d", port)},
+			},
+		},
+	}
+
+	container := corev1.Container{
+		Name:            "local-replica",
+		Image:           storage.Spec.Image,
+		ImagePullPolicy: storage.Spec.ImagePullPolicy,
+		Resources:       storage.Spec.Resources,
+		Args: []string{
+			fmt.Sprintf("--config=%s/%s", configPath, clusterConfigFile),
+			fmt.Sprintf("--protocol-config=%s/%s", configPath, protocolConfigFile),
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "api",
+				ContainerPort: port,
+			},
+			{
+				Name:          "protocol",
+				ContainerPort: port,
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      configVolume,
+				MountPath: configPath,
+				ReadOnly:  true,
+			},
+			{
+				Name:      dataVolume,
+				MountPath: dataPath,
+			},
+		},
+		ReadinessProbe: probe,
+		LivenessProbe:  probe,
+	}
+
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      cluster.Name,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"cluster": cluster.Name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"cluster": cluster.Name,
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{container},
+					Volumes: []corev1.Volume{
+						{
+							Name: configVolume,
+							VolumeSource: corev1.VolumeSource{
+								Projected: &corev1.ProjectedVolumeSource{
+									Sources: []corev1.VolumeProjection{
+										{
+											ConfigMap: &corev1.ConfigMapProjection{
+												LocalObjectReference: corev1.LocalObjectReference{
+													Name: cluster.Name,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Name:         dataVolume,
+							VolumeSource: dataVolumeSource,
+						},
+					},
+				},
+			},
+		},
+	}
+	log.Info("Applying Deployment", "Name", cluster.Name, "Namespace", cluster.Namespace)
+	return r.applyOwnedObject(context.TODO(), c, cluster, dep)
 }
 
-func (r *Reconciler) addService(cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
-	log.Info("Creating service", "Name", cluster.Name, "Namespace", cluster.Namespace)
+func (r *Reconciler) reconcileService(c client.Client, cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
 	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: cluster.Namespace,
 			Name:      cluster.Name,
 		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"cluster": cluster.Name,
+			},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "api",
+					Port:       port,
+					TargetPort: intstr.FromInt(port),
+				},
+				{
+					Name:       "protocol",
+					Port:       port,
+					TargetPort: intstr.FromInt(port),
+				},
+			},
+		},
 	}
-	if err := controllerutil.SetControllerReference(cluster, service, r.scheme); err != nil {
-		return err
-	}
-	return r.client.Create(context.TODO(), service)
+	log.Info("Applying Service", "Name", cluster.Name, "Namespace", cluster.Namespace)
+	return r.applyOwnedObject(context.TODO(), c, cluster, service)
 }
 
-func (r *Reconciler) reconcileStatus(cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
+func (r *Reconciler) reconcileStatus(c client.Client, cluster *v1beta2.Cluster, storage *v1beta1.CacheStorage) error {
 	dep := &appsv1.Deployment{}
 	name := types.NamespacedName{
 		Namespace: cluster.Namespace,
 		Name:      cluster.Name,
 	}
-	err := r.client.Get(context.TODO(), name, dep)
+	err := c.Get(context.TODO(), name, dep)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil
@@ -238,14 +681,14 @@ func (r *Reconciler) reconcileStatus(cluster *v1beta2.Cluster, storage *v1beta1.
 		}
 		for partitionID := (cluster.Spec.Partitions * (clusterID - 1)) + 1; partitionID <= cluster.Spec.Partitions*clusterID; partitionID++ {
 			partition := &v1beta2.Partition{}
-			err := r.client.Get(context.TODO(), k8s.GetPartitionNamespacedName(cluster, partitionID), partition)
+			err := c.Get(context.TODO(), k8s.GetPartitionNamespacedName(cluster, partitionID), partition)
 			if err != nil && !k8serrors.IsNotFound(err) {
 				return err
 			}
 			if !partition.Status.Ready {
 				partition.Status.Ready = true
 				log.Info("Updating Partition status", "Name", partition.Name, "Namespace", partition.Namespace, "Ready", partition.Status.Ready)
-				err = r.client.Status().Update(context.TODO(), partition)
+				err = c.Status().Update(context.TODO(), partition)
 				if err != nil {
 					return err
 				}
@@ -255,11 +698,53 @@ func (r *Reconciler) reconcileStatus(cluster *v1beta2.Cluster, storage *v1beta1.
 		// If we've made it this far, all partitions are ready. Update the cluster status
 		cluster.Status.ReadyPartitions = cluster.Spec.Partitions
 		log.Info("Updating Cluster status", "Name", cluster.Name, "Namespace", cluster.Namespace, "ReadyPartitions", cluster.Status.ReadyPartitions)
-		return r.client.Status().Update(context.TODO(), cluster)
+		if err := c.Status().Update(context.TODO(), cluster); err != nil {
+			return err
+		}
+		return r.setCacheStorageReady(c, storage, corev1.ConditionTrue, "DeploymentReady", "")
+	} else if dep.Status.ReadyReplicas != dep.Status.Replicas {
+		message := fmt.Sprintf("%d/%d replicas ready", dep.Status.ReadyReplicas, dep.Status.Replicas)
+		log.Info("Deployment not ready", "Name", dep.Name, "Namespace", dep.Namespace, "ReadyReplicas", dep.Status.ReadyReplicas, "Replicas", dep.Status.Replicas, "Reason", "NotReady")
+		return r.setCacheStorageReady(c, storage, corev1.ConditionFalse, "NotReady", message)
+	} else if cluster.Status.ReadyPartitions >= cluster.Spec.Partitions {
+		return r.setCacheStorageReady(c, storage, corev1.ConditionTrue, "DeploymentReady", "")
 	}
 	return nil
 }
 
+// setCacheStorageReady sets the CacheStorage's Ready condition, persisting the change only if the
+// condition's status or reason actually moved so a steady state doesn't generate a write every reconcile.
+func (r *Reconciler) setCacheStorageReady(c client.Client, storage *v1beta1.CacheStorage, status corev1.ConditionStatus, reason, message string) error {
+	for i := range storage.Status.Conditions {
+		cond := &storage.Status.Conditions[i]
+		if cond.Type != v1beta1.CacheStorageConditionReady {
+			continue
+		}
+		if cond.Status == status && cond.Reason == reason {
+			return nil
+		}
+		cond.Status = status
+		cond.Reason = reason
+		cond.Message = message
+		cond.LastTransitionTime = metav1.Now()
+		return c.Status().Update(context.TODO(), storage)
+	}
+
+	storage.Status.Conditions = append(storage.Status.Conditions, v1beta1.CacheStorageCondition{
+		Type:               v1beta1.CacheStorageConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+	return c.Status().Update(context.TODO(), storage)
+}
+
+// newProtocolConfigString creates the protocol configuration string mounted alongside the cluster config
+func newProtocolConfigString() string {
+	return "{}"
+}
+
 // newNodeConfigString creates a node configuration string for the given cluster
 func newClusterConfig(cluster *v1beta2.Cluster) (*api.ClusterConfig, error) {
 	database := cluster.Annotations["cloud.atomix.io/database"]
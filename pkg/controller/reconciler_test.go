@@ -0,0 +1,246 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/atomix/kubernetes-controller/pkg/apis/cloud/v1beta2"
+	"github.com/atomix/kubernetes-controller/pkg/controller/v1beta2/util/k8s"
+	"github.com/atomix/local-replica/pkg/apis/storage/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	s := runtime.NewScheme()
+	if err := scheme.AddToScheme(s); err != nil {
+		t.Fatalf("failed to register builtin types: %v", err)
+	}
+	if err := v1beta2.AddToScheme(s); err != nil {
+		t.Fatalf("failed to register v1beta2 types: %v", err)
+	}
+	if err := v1beta1.AddToScheme(s); err != nil {
+		t.Fatalf("failed to register v1beta1 types: %v", err)
+	}
+	return s
+}
+
+func newTestCluster() *v1beta2.Cluster {
+	return &v1beta2.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "test",
+			Finalizers: []string{finalizer},
+			Annotations: map[string]string{
+				"cloud.atomix.io/cluster":  "1",
+				"cloud.atomix.io/database": "test",
+			},
+		},
+		Spec: v1beta2.ClusterSpec{
+			Partitions: 1,
+			Storage: v1beta2.ClusterStorage{
+				Namespace: "default",
+				Name:      "test",
+			},
+		},
+	}
+}
+
+// TestReconcileDelete_HappyPath verifies that a Cluster with no Deployment, PersistentVolumeClaim, or
+// Partitions left behind has its finalizer removed in a single pass.
+func TestReconcileDelete_HappyPath(t *testing.T) {
+	s := newTestScheme(t)
+	cluster := newTestCluster()
+	c := fake.NewFakeClientWithScheme(s, cluster)
+	r := &Reconciler{scheme: s}
+
+	result, err := r.reconcileDelete(c, cluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Requeue {
+		t.Fatal("expected teardown to complete without requeuing")
+	}
+
+	updated := &v1beta2.Cluster{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Name}, updated); err != nil {
+		t.Fatal(err)
+	}
+	if containsString(updated.GetFinalizers(), finalizer) {
+		t.Fatal("expected finalizer to be removed")
+	}
+}
+
+// TestReconcileDelete_PartitionsStillReady verifies that terminatePartitions marks every Partition the
+// cluster owns not-ready before the Deployment is scaled down, so in-flight requests stop being routed
+// to the cluster as soon as teardown begins rather than after pods have already terminated.
+func TestReconcileDelete_PartitionsStillReady(t *testing.T) {
+	s := newTestScheme(t)
+	cluster := newTestCluster()
+
+	clusterID, err := k8s.GetClusterIDFromClusterAnnotations(cluster)
+	if err != nil {
+		t.Fatalf("failed to compute cluster ID: %v", err)
+	}
+	partitionName := k8s.GetPartitionNamespacedName(cluster, clusterID)
+	partition := &v1beta2.Partition{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: partitionName.Namespace,
+			Name:      partitionName.Name,
+		},
+		Status: v1beta2.PartitionStatus{
+			Ready: true,
+		},
+	}
+
+	replicas := int32(2)
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      cluster.Name,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas: 2,
+		},
+	}
+
+	c := fake.NewFakeClientWithScheme(s, cluster, partition, dep)
+	r := &Reconciler{scheme: s}
+
+	result, err := r.reconcileDelete(c, cluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Requeue {
+		t.Fatal("expected a requeue while the Deployment is scaled down")
+	}
+
+	updatedPartition := &v1beta2.Partition{}
+	if err := c.Get(context.TODO(), partitionName, updatedPartition); err != nil {
+		t.Fatal(err)
+	}
+	if updatedPartition.Status.Ready {
+		t.Fatal("expected Partition to be marked not-ready before the Deployment is scaled down")
+	}
+
+	updatedDep := &appsv1.Deployment{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Name}, updatedDep); err != nil {
+		t.Fatal(err)
+	}
+	if *updatedDep.Spec.Replicas != 0 {
+		t.Fatal("expected Deployment to be scaled to 0 replicas")
+	}
+}
+
+// TestReconcileStatus_NotReady verifies that a Deployment with unready replicas surfaces a NotReady
+// Ready condition on the CacheStorage rather than only being logged.
+func TestReconcileStatus_NotReady(t *testing.T) {
+	s := newTestScheme(t)
+	cluster := newTestCluster()
+	storage := &v1beta1.CacheStorage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Spec.Storage.Namespace,
+			Name:      cluster.Spec.Storage.Name,
+		},
+	}
+
+	replicas := int32(2)
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      cluster.Name,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:      2,
+			ReadyReplicas: 1,
+		},
+	}
+
+	c := fake.NewFakeClientWithScheme(s, cluster, storage, dep)
+	r := &Reconciler{scheme: s}
+
+	if err := r.reconcileStatus(c, cluster, storage); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated := &v1beta1.CacheStorage{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: storage.Namespace, Name: storage.Name}, updated); err != nil {
+		t.Fatal(err)
+	}
+
+	var ready *v1beta1.CacheStorageCondition
+	for i := range updated.Status.Conditions {
+		if updated.Status.Conditions[i].Type == v1beta1.CacheStorageConditionReady {
+			ready = &updated.Status.Conditions[i]
+		}
+	}
+	if ready == nil {
+		t.Fatal("expected a Ready condition to be set")
+	}
+	if ready.Status != corev1.ConditionFalse {
+		t.Fatalf("expected Ready condition to be False, got %q", ready.Status)
+	}
+	if ready.Reason != "NotReady" {
+		t.Fatalf("expected reason NotReady, got %q", ready.Reason)
+	}
+}
+
+// TestReconcilePersistentVolumeClaimDelete_Retains verifies that a CacheStorage with
+// PersistentVolumeReclaimPolicy set to Retain leaves the data PersistentVolumeClaim in place rather
+// than deleting it.
+func TestReconcilePersistentVolumeClaimDelete_Retains(t *testing.T) {
+	s := newTestScheme(t)
+	cluster := newTestCluster()
+	storage := &v1beta1.CacheStorage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Spec.Storage.Namespace,
+			Name:      cluster.Spec.Storage.Name,
+		},
+		Spec: v1beta1.CacheStorageSpec{
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+		},
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      cluster.Name,
+		},
+	}
+
+	c := fake.NewFakeClientWithScheme(s, cluster, storage, pvc)
+	r := &Reconciler{scheme: s}
+
+	if err := r.reconcilePersistentVolumeClaimDelete(c, cluster); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	retained := &corev1.PersistentVolumeClaim{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Name}, retained); err != nil {
+		t.Fatalf("expected PersistentVolumeClaim to be retained: %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2019-present Open Networking Foundation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// servicePredicate filters Service events down to changes in the ports or selector we manage
+type servicePredicate struct {
+}
+
+func (p *servicePredicate) Create(e event.CreateEvent) bool {
+	return true
+}
+
+func (p *servicePredicate) Delete(e event.DeleteEvent) bool {
+	return true
+}
+
+func (p *servicePredicate) Update(e event.UpdateEvent) bool {
+	oldSvc, ok := e.ObjectOld.(*corev1.Service)
+	if !ok {
+		return false
+	}
+	newSvc, ok := e.ObjectNew.(*corev1.Service)
+	if !ok {
+		return false
+	}
+
+	if !reflect.DeepEqual(oldSvc.Spec.Ports, newSvc.Spec.Ports) {
+		return true
+	}
+	return !reflect.DeepEqual(oldSvc.Spec.Selector, newSvc.Spec.Selector)
+}
+
+func (p *servicePredicate) Generic(e event.GenericEvent) bool {
+	return false
+}